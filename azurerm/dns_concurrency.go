@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The DNS record resources default to last-write-wins, matching their
+// historical behaviour of always passing an empty ETag/If-None-Match to
+// RecordSetsClient.CreateOrUpdate. if_match and if_none_match_on_create opt
+// a resource into optimistic concurrency so that a concurrent Terraform run,
+// or an out-of-band edit in the Azure Portal, is detected instead of
+// silently clobbered.
+const (
+	dnsConcurrencyModeLastWriteWins       = "last_write_wins"
+	dnsConcurrencyModeIfMatch             = "if_match"
+	dnsConcurrencyModeIfNoneMatchOnCreate = "if_none_match_on_create"
+)
+
+// KNOWN LIMITATION, not yet signed off by a maintainer: the original request
+// asked for a provider-level `dns_concurrency_mode` argument, set once in the
+// `provider "azurerm" {}` block, so migrating every existing
+// `azurerm_dns_*_record` resource to `if_match` doesn't mean editing every
+// block. That requires adding the argument to Provider()'s top-level Schema
+// and threading the resolved value onto ArmClient in providerConfigure - both
+// of which live in provider.go/config.go, neither of which exists anywhere in
+// this tree (grep for "func Provider()" or "type ArmClient struct" and there's
+// nothing to edit). Fabricating a standalone ArmClient/Provider() here would
+// risk colliding with the real ones once this tree is merged back, which is
+// worse than leaving this open. Until a maintainer either supplies those
+// files or signs off on the env-var shim below as good enough, treat this as
+// NOT satisfying the original request.
+//
+// schemaDnsConcurrencyMode returns the `concurrency_mode` attribute shared by
+// every `azurerm_dns_*_record` resource, defaulting from the
+// ARM_DNS_CONCURRENCY_MODE environment variable (the provider's existing
+// DefaultFunc convention for cross-cutting settings, e.g. ARM_SUBSCRIPTION_ID)
+// as a stopgap that at least lets an operator set the mode once per process
+// rather than per resource block.
+func schemaDnsConcurrencyMode() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("ARM_DNS_CONCURRENCY_MODE", dnsConcurrencyModeLastWriteWins),
+		ValidateFunc: validation.StringInSlice([]string{
+			dnsConcurrencyModeLastWriteWins,
+			dnsConcurrencyModeIfMatch,
+			dnsConcurrencyModeIfNoneMatchOnCreate,
+		}, false),
+	}
+}
+
+// schemaDnsEtag returns the computed `etag` attribute shared by every
+// `azurerm_dns_*_record` resource, populated from the record set's ETag on
+// Read.
+func schemaDnsEtag() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// dnsRecordSetPreconditions returns the ifMatch/ifNoneMatch values that
+// should be passed to RecordSetsClient.CreateOrUpdate for the resource's
+// configured `concurrency_mode`.
+func dnsRecordSetPreconditions(d *schema.ResourceData) (ifMatch string, ifNoneMatch string) {
+	switch d.Get("concurrency_mode").(string) {
+	case dnsConcurrencyModeIfMatch:
+		if !d.IsNewResource() {
+			ifMatch = d.Get("etag").(string)
+		}
+	case dnsConcurrencyModeIfNoneMatchOnCreate:
+		if d.IsNewResource() {
+			ifNoneMatch = "*"
+		}
+	}
+
+	return ifMatch, ifNoneMatch
+}
+
+// dnsRecordSetConcurrencyError wraps a CreateOrUpdate error with actionable
+// guidance when Azure rejected the write because the record set was
+// modified since it was last read (an If-Match/If-None-Match precondition
+// failure, surfaced by Azure as HTTP 412).
+func dnsRecordSetConcurrencyError(resourceType, name string, err error) error {
+	if !isPreconditionFailed(err) {
+		return err
+	}
+
+	return fmt.Errorf("%s %q was modified out of band (e.g. in the Azure Portal, or by another Terraform run) since it was last read; run `terraform refresh` and re-apply: %s", resourceType, name, err)
+}
+
+func isPreconditionFailed(err error) bool {
+	detailed, ok := err.(autorest.DetailedError)
+	if !ok {
+		return false
+	}
+
+	code, ok := detailed.StatusCode.(int)
+	return ok && code == http.StatusPreconditionFailed
+}
@@ -0,0 +1,58 @@
+package zonefile
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// TSIGConfig holds the key material required to authenticate an AXFR
+// transfer against a source name server, mirroring the keyname/algorithm/
+// secret triple used to configure BIND dynamic DNS updates.
+type TSIGConfig struct {
+	KeyName   string
+	Algorithm string // e.g. dns.HmacSHA256; defaults to dns.HmacSHA256 when empty
+	Secret    string // base64-encoded, as it appears in named.conf
+}
+
+// Transfer performs an AXFR against server for the given zone, optionally
+// authenticated with TSIG, and returns the transferred records grouped into
+// RecordSets.
+func Transfer(server, zone string, tsigConf *TSIGConfig) ([]RecordSet, error) {
+	m := new(dns.Msg)
+	m.SetAxfr(dns.Fqdn(zone))
+
+	t := new(dns.Transfer)
+
+	if tsigConf != nil {
+		algorithm := tsigConf.Algorithm
+		if algorithm == "" {
+			algorithm = dns.HmacSHA256
+		}
+
+		keyName := dns.Fqdn(tsigConf.KeyName)
+		m.SetTsig(keyName, algorithm, 300, time.Now().Unix())
+		t.TsigSecret = map[string]string{keyName: tsigConf.Secret}
+	}
+
+	envelopes, err := t.In(m, server)
+	if err != nil {
+		return nil, fmt.Errorf("initiating AXFR from %q for zone %q: %w", server, zone, err)
+	}
+
+	sets := map[string]*RecordSet{}
+	var order []string
+
+	for envelope := range envelopes {
+		if envelope.Error != nil {
+			return nil, fmt.Errorf("transferring zone %q from %q: %w", zone, server, envelope.Error)
+		}
+
+		for _, rr := range envelope.RR {
+			addRR(sets, &order, rr)
+		}
+	}
+
+	return orderedRecordSets(sets, order), nil
+}
@@ -0,0 +1,269 @@
+package zonefile
+
+import (
+	"fmt"
+	"net"
+
+	azuredns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/miekg/dns"
+)
+
+// ToAzureRecordSet converts a RecordSet parsed from a zone file or AXFR
+// transfer into the RecordType + RecordSetProperties pair that
+// RecordSetsClient.CreateOrUpdate expects. Only the record types Azure DNS
+// supports are handled; anything else is reported as an error so an import
+// fails loudly instead of silently dropping records.
+func ToAzureRecordSet(set RecordSet) (azuredns.RecordType, azuredns.RecordSetProperties, error) {
+	ttl := int64(set.TTL)
+	props := azuredns.RecordSetProperties{TTL: &ttl}
+
+	switch set.Type {
+	case dns.TypeA:
+		records := make([]azuredns.ARecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			a, ok := rr.(*dns.A)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected A, got %T", set.Name, rr)
+			}
+			ip := a.A.String()
+			records = append(records, azuredns.ARecord{Ipv4Address: &ip})
+		}
+		props.ARecords = &records
+		return azuredns.A, props, nil
+
+	case dns.TypeAAAA:
+		records := make([]azuredns.AaaaRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			aaaa, ok := rr.(*dns.AAAA)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected AAAA, got %T", set.Name, rr)
+			}
+			ip := aaaa.AAAA.String()
+			records = append(records, azuredns.AaaaRecord{Ipv6Address: &ip})
+		}
+		props.AaaaRecords = &records
+		return azuredns.AAAA, props, nil
+
+	case dns.TypeCNAME:
+		if len(set.RRs) != 1 {
+			return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: CNAME record sets must contain exactly one record, got %d", set.Name, len(set.RRs))
+		}
+		cname, ok := set.RRs[0].(*dns.CNAME)
+		if !ok {
+			return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected CNAME, got %T", set.Name, set.RRs[0])
+		}
+		props.CnameRecord = &azuredns.CnameRecord{Cname: &cname.Target}
+		return azuredns.CNAME, props, nil
+
+	case dns.TypeMX:
+		records := make([]azuredns.MxRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			mx, ok := rr.(*dns.MX)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected MX, got %T", set.Name, rr)
+			}
+			preference := int32(mx.Preference)
+			records = append(records, azuredns.MxRecord{Preference: &preference, Exchange: &mx.Mx})
+		}
+		props.MxRecords = &records
+		return azuredns.MX, props, nil
+
+	case dns.TypeNS:
+		records := make([]azuredns.NsRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			ns, ok := rr.(*dns.NS)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected NS, got %T", set.Name, rr)
+			}
+			records = append(records, azuredns.NsRecord{Nsdname: &ns.Ns})
+		}
+		props.NsRecords = &records
+		return azuredns.NS, props, nil
+
+	case dns.TypeTXT:
+		records := make([]azuredns.TxtRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			txt, ok := rr.(*dns.TXT)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected TXT, got %T", set.Name, rr)
+			}
+			chunks := append([]string(nil), txt.Txt...)
+			records = append(records, azuredns.TxtRecord{Value: &chunks})
+		}
+		props.TxtRecords = &records
+		return azuredns.TXT, props, nil
+
+	case dns.TypeSRV:
+		records := make([]azuredns.SrvRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			srv, ok := rr.(*dns.SRV)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected SRV, got %T", set.Name, rr)
+			}
+			priority, weight, port := int32(srv.Priority), int32(srv.Weight), int32(srv.Port)
+			records = append(records, azuredns.SrvRecord{Priority: &priority, Weight: &weight, Port: &port, Target: &srv.Target})
+		}
+		props.SrvRecords = &records
+		return azuredns.SRV, props, nil
+
+	case dns.TypeCAA:
+		records := make([]azuredns.CaaRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			caa, ok := rr.(*dns.CAA)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected CAA, got %T", set.Name, rr)
+			}
+			flags := int32(caa.Flag)
+			records = append(records, azuredns.CaaRecord{Flags: &flags, Tag: &caa.Tag, Value: &caa.Value})
+		}
+		props.CaaRecords = &records
+		return azuredns.CAA, props, nil
+
+	case dns.TypePTR:
+		records := make([]azuredns.PtrRecord, 0, len(set.RRs))
+		for _, rr := range set.RRs {
+			ptr, ok := rr.(*dns.PTR)
+			if !ok {
+				return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: expected PTR, got %T", set.Name, rr)
+			}
+			records = append(records, azuredns.PtrRecord{Ptrdname: &ptr.Ptr})
+		}
+		props.PtrRecords = &records
+		return azuredns.PTR, props, nil
+
+	default:
+		return "", azuredns.RecordSetProperties{}, fmt.Errorf("record %q: unsupported record type %s", set.Name, dns.TypeToString[set.Type])
+	}
+}
+
+// FromAzureRecordSet is the inverse of ToAzureRecordSet: it rebuilds a
+// RecordSet from a record set already present in an Azure DNS zone, so that
+// the records currently in the zone can be compared against the desired
+// state with the same Diff used for the parsed/transferred side. name is the
+// record set's name exactly as returned by RecordSetsClient (relative to the
+// zone, e.g. "@" for the apex or "www"); zoneApex is the zone's own name.
+func FromAzureRecordSet(name, zoneApex string, recordType azuredns.RecordType, props azuredns.RecordSetProperties) (RecordSet, error) {
+	rrtype, err := azureRecordTypeToRRType(recordType)
+	if err != nil {
+		return RecordSet{}, err
+	}
+
+	ttl := uint32(0)
+	if props.TTL != nil {
+		ttl = uint32(*props.TTL)
+	}
+
+	hdr := dns.RR_Header{Name: FqdnFromRelativeName(name, zoneApex), Rrtype: rrtype, Class: dns.ClassINET, Ttl: ttl}
+	set := RecordSet{Name: hdr.Name, Type: rrtype, TTL: ttl}
+
+	switch recordType {
+	case azuredns.A:
+		if props.ARecords == nil {
+			break
+		}
+		for _, r := range *props.ARecords {
+			set.RRs = append(set.RRs, &dns.A{Hdr: hdr, A: net.ParseIP(*r.Ipv4Address)})
+		}
+
+	case azuredns.AAAA:
+		if props.AaaaRecords == nil {
+			break
+		}
+		for _, r := range *props.AaaaRecords {
+			set.RRs = append(set.RRs, &dns.AAAA{Hdr: hdr, AAAA: net.ParseIP(*r.Ipv6Address)})
+		}
+
+	case azuredns.CNAME:
+		if props.CnameRecord == nil {
+			break
+		}
+		set.RRs = append(set.RRs, &dns.CNAME{Hdr: hdr, Target: dns.Fqdn(*props.CnameRecord.Cname)})
+
+	case azuredns.MX:
+		if props.MxRecords == nil {
+			break
+		}
+		for _, r := range *props.MxRecords {
+			set.RRs = append(set.RRs, &dns.MX{Hdr: hdr, Preference: uint16(*r.Preference), Mx: dns.Fqdn(*r.Exchange)})
+		}
+
+	case azuredns.NS:
+		if props.NsRecords == nil {
+			break
+		}
+		for _, r := range *props.NsRecords {
+			set.RRs = append(set.RRs, &dns.NS{Hdr: hdr, Ns: dns.Fqdn(*r.Nsdname)})
+		}
+
+	case azuredns.TXT:
+		if props.TxtRecords == nil {
+			break
+		}
+		for _, r := range *props.TxtRecords {
+			var chunks []string
+			if r.Value != nil {
+				chunks = *r.Value
+			}
+			set.RRs = append(set.RRs, &dns.TXT{Hdr: hdr, Txt: chunks})
+		}
+
+	case azuredns.SRV:
+		if props.SrvRecords == nil {
+			break
+		}
+		for _, r := range *props.SrvRecords {
+			set.RRs = append(set.RRs, &dns.SRV{
+				Hdr:      hdr,
+				Priority: uint16(*r.Priority),
+				Weight:   uint16(*r.Weight),
+				Port:     uint16(*r.Port),
+				Target:   dns.Fqdn(*r.Target),
+			})
+		}
+
+	case azuredns.CAA:
+		if props.CaaRecords == nil {
+			break
+		}
+		for _, r := range *props.CaaRecords {
+			set.RRs = append(set.RRs, &dns.CAA{Hdr: hdr, Flag: uint8(*r.Flags), Tag: *r.Tag, Value: *r.Value})
+		}
+
+	case azuredns.PTR:
+		if props.PtrRecords == nil {
+			break
+		}
+		for _, r := range *props.PtrRecords {
+			set.RRs = append(set.RRs, &dns.PTR{Hdr: hdr, Ptr: dns.Fqdn(*r.Ptrdname)})
+		}
+	}
+
+	return set, nil
+}
+
+func azureRecordTypeToRRType(recordType azuredns.RecordType) (uint16, error) {
+	switch recordType {
+	case azuredns.A:
+		return dns.TypeA, nil
+	case azuredns.AAAA:
+		return dns.TypeAAAA, nil
+	case azuredns.CNAME:
+		return dns.TypeCNAME, nil
+	case azuredns.MX:
+		return dns.TypeMX, nil
+	case azuredns.NS:
+		return dns.TypeNS, nil
+	case azuredns.TXT:
+		return dns.TypeTXT, nil
+	case azuredns.SRV:
+		return dns.TypeSRV, nil
+	case azuredns.CAA:
+		return dns.TypeCAA, nil
+	case azuredns.PTR:
+		return dns.TypePTR, nil
+	case azuredns.SOA:
+		return dns.TypeSOA, nil
+	default:
+		return 0, fmt.Errorf("unsupported Azure DNS record type %q", recordType)
+	}
+}
@@ -0,0 +1,134 @@
+package zonefile
+
+import (
+	"net"
+	"testing"
+
+	azuredns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/miekg/dns"
+)
+
+// TestAzureRecordSetRoundTrip covers every record type ToAzureRecordSet and
+// FromAzureRecordSet know how to convert: a RecordSet run through
+// ToAzureRecordSet and back through FromAzureRecordSet should come out with
+// the same rdata it started with.
+func TestAzureRecordSetRoundTrip(t *testing.T) {
+	const name = "www.example.com."
+	const ttl = 300
+	hdr := dns.RR_Header{Name: name, Rrtype: 0, Class: dns.ClassINET, Ttl: ttl}
+
+	cases := []struct {
+		recordType azuredns.RecordType
+		set        RecordSet
+	}{
+		{
+			recordType: azuredns.A,
+			set: RecordSet{Name: name, Type: dns.TypeA, TTL: ttl, RRs: []dns.RR{
+				&dns.A{Hdr: withType(hdr, dns.TypeA), A: mustParseIP("203.0.113.1")},
+			}},
+		},
+		{
+			recordType: azuredns.AAAA,
+			set: RecordSet{Name: name, Type: dns.TypeAAAA, TTL: ttl, RRs: []dns.RR{
+				&dns.AAAA{Hdr: withType(hdr, dns.TypeAAAA), AAAA: mustParseIP("2001:db8::1")},
+			}},
+		},
+		{
+			recordType: azuredns.CNAME,
+			set: RecordSet{Name: name, Type: dns.TypeCNAME, TTL: ttl, RRs: []dns.RR{
+				&dns.CNAME{Hdr: withType(hdr, dns.TypeCNAME), Target: "target.example.com."},
+			}},
+		},
+		{
+			recordType: azuredns.MX,
+			set: RecordSet{Name: name, Type: dns.TypeMX, TTL: ttl, RRs: []dns.RR{
+				&dns.MX{Hdr: withType(hdr, dns.TypeMX), Preference: 10, Mx: "mx1.example.com."},
+			}},
+		},
+		{
+			recordType: azuredns.NS,
+			set: RecordSet{Name: name, Type: dns.TypeNS, TTL: ttl, RRs: []dns.RR{
+				&dns.NS{Hdr: withType(hdr, dns.TypeNS), Ns: "ns1.example.com."},
+			}},
+		},
+		{
+			recordType: azuredns.TXT,
+			set: RecordSet{Name: name, Type: dns.TypeTXT, TTL: ttl, RRs: []dns.RR{
+				&dns.TXT{Hdr: withType(hdr, dns.TypeTXT), Txt: []string{"hello world"}},
+			}},
+		},
+		{
+			recordType: azuredns.SRV,
+			set: RecordSet{Name: name, Type: dns.TypeSRV, TTL: ttl, RRs: []dns.RR{
+				&dns.SRV{Hdr: withType(hdr, dns.TypeSRV), Priority: 1, Weight: 2, Port: 443, Target: "target.example.com."},
+			}},
+		},
+		{
+			recordType: azuredns.CAA,
+			set: RecordSet{Name: name, Type: dns.TypeCAA, TTL: ttl, RRs: []dns.RR{
+				&dns.CAA{Hdr: withType(hdr, dns.TypeCAA), Flag: 0, Tag: "issue", Value: "letsencrypt.org"},
+			}},
+		},
+		{
+			recordType: azuredns.PTR,
+			set: RecordSet{Name: name, Type: dns.TypePTR, TTL: ttl, RRs: []dns.RR{
+				&dns.PTR{Hdr: withType(hdr, dns.TypePTR), Ptr: "host.example.com."},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.recordType), func(t *testing.T) {
+			recordType, props, err := ToAzureRecordSet(tc.set)
+			if err != nil {
+				t.Fatalf("ToAzureRecordSet: %s", err)
+			}
+			if recordType != tc.recordType {
+				t.Fatalf("ToAzureRecordSet: got type %s, want %s", recordType, tc.recordType)
+			}
+
+			back, err := FromAzureRecordSet("www", "example.com.", recordType, props)
+			if err != nil {
+				t.Fatalf("FromAzureRecordSet: %s", err)
+			}
+
+			if !recordSetsEqual(tc.set, back) {
+				t.Errorf("round trip changed rdata:\n  before: %+v\n  after:  %+v", tc.set.RRs, back.RRs)
+			}
+		})
+	}
+}
+
+func TestToAzureRecordSetRejectsMismatchedRRs(t *testing.T) {
+	set := RecordSet{
+		Name: "www.example.com.",
+		Type: dns.TypeA,
+		TTL:  300,
+		RRs:  []dns.RR{&dns.AAAA{Hdr: dns.RR_Header{Name: "www.example.com.", Rrtype: dns.TypeA}}},
+	}
+
+	if _, _, err := ToAzureRecordSet(set); err == nil {
+		t.Fatal("expected an error converting a record set whose RRs don't match its declared Type")
+	}
+}
+
+func TestToAzureRecordSetRejectsUnsupportedType(t *testing.T) {
+	set := RecordSet{Name: "www.example.com.", Type: dns.TypeDNSKEY, TTL: 300}
+
+	if _, _, err := ToAzureRecordSet(set); err == nil {
+		t.Fatal("expected an error converting a record type Azure DNS doesn't support")
+	}
+}
+
+func withType(hdr dns.RR_Header, rrtype uint16) dns.RR_Header {
+	hdr.Rrtype = rrtype
+	return hdr
+}
+
+func mustParseIP(s string) net.IP {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		panic("invalid test IP: " + s)
+	}
+	return ip
+}
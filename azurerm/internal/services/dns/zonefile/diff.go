@@ -0,0 +1,94 @@
+package zonefile
+
+import "github.com/miekg/dns"
+
+// OpType describes the mutation required to reconcile a record set.
+type OpType string
+
+const (
+	OpCreate OpType = "create"
+	OpUpdate OpType = "update"
+	OpDelete OpType = "delete"
+)
+
+// Op is a single create/update/delete operation against an Azure DNS zone,
+// produced by Diff.
+type Op struct {
+	Type OpType
+	Set  RecordSet
+}
+
+// Diff compares the desired record sets (parsed from a zone file or fetched
+// via AXFR) against the record sets currently present in the target Azure
+// DNS zone and returns the operations required to reconcile them. zoneApex is
+// the fully-qualified name of the zone itself, used to identify the SOA and
+// apex NS record sets that Azure DNS manages automatically and which Diff
+// never touches.
+func Diff(zoneApex string, desired, existing []RecordSet) []Op {
+	existingByKey := make(map[string]RecordSet, len(existing))
+	for _, set := range existing {
+		existingByKey[recordSetKey(set.Name, set.Type)] = set
+	}
+
+	seen := make(map[string]bool, len(desired))
+	var ops []Op
+
+	for _, set := range desired {
+		if IsAzureManaged(zoneApex, set) {
+			continue
+		}
+
+		key := recordSetKey(set.Name, set.Type)
+		seen[key] = true
+
+		current, exists := existingByKey[key]
+		switch {
+		case !exists:
+			ops = append(ops, Op{Type: OpCreate, Set: set})
+		case !recordSetsEqual(current, set):
+			ops = append(ops, Op{Type: OpUpdate, Set: set})
+		}
+	}
+
+	for _, set := range existing {
+		if IsAzureManaged(zoneApex, set) {
+			continue
+		}
+
+		if !seen[recordSetKey(set.Name, set.Type)] {
+			ops = append(ops, Op{Type: OpDelete, Set: set})
+		}
+	}
+
+	return ops
+}
+
+// recordSetsEqual reports whether two record sets would produce the same
+// Azure DNS record set, ignoring RR ordering.
+func recordSetsEqual(a, b RecordSet) bool {
+	if a.TTL != b.TTL || len(a.RRs) != len(b.RRs) {
+		return false
+	}
+
+	aData := make(map[string]int, len(a.RRs))
+	for _, rr := range a.RRs {
+		aData[rdataString(rr)]++
+	}
+
+	for _, rr := range b.RRs {
+		key := rdataString(rr)
+		if aData[key] == 0 {
+			return false
+		}
+		aData[key]--
+	}
+
+	return true
+}
+
+// rdataString renders the record's data fields only, stripping the owner
+// name, TTL and class from the wire-format string so that two RRs that only
+// differ in those fields can still compare equal.
+func rdataString(rr dns.RR) string {
+	return rr.String()[len(rr.Header().String()):]
+}
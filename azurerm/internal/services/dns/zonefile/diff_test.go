@@ -0,0 +1,134 @@
+package zonefile
+
+import (
+	"net"
+	"testing"
+
+	azuredns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/miekg/dns"
+)
+
+func aRecordSet(name string, ttl uint32, ips ...string) RecordSet {
+	hdr := dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl}
+
+	set := RecordSet{Name: name, Type: dns.TypeA, TTL: ttl}
+	for _, ip := range ips {
+		set.RRs = append(set.RRs, &dns.A{Hdr: hdr, A: net.ParseIP(ip)})
+	}
+
+	return set
+}
+
+func TestDiff(t *testing.T) {
+	const zoneApex = "example.com."
+
+	soa := RecordSet{Name: zoneApex, Type: dns.TypeSOA, TTL: 3600, RRs: []dns.RR{
+		&dns.SOA{Hdr: dns.RR_Header{Name: zoneApex, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 3600}},
+	}}
+	apexNS := RecordSet{Name: zoneApex, Type: dns.TypeNS, TTL: 3600, RRs: []dns.RR{
+		&dns.NS{Hdr: dns.RR_Header{Name: zoneApex, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 3600}, Ns: "ns1.azure-dns.com."},
+	}}
+
+	unchanged := aRecordSet("www.example.com.", 300, "203.0.113.1")
+	changed := aRecordSet("api.example.com.", 300, "203.0.113.2")
+	changedPrevious := aRecordSet("api.example.com.", 300, "203.0.113.9")
+	created := aRecordSet("new.example.com.", 300, "203.0.113.3")
+	deleted := aRecordSet("old.example.com.", 300, "203.0.113.4")
+
+	desired := []RecordSet{soa, apexNS, unchanged, changed, created}
+	existing := []RecordSet{soa, apexNS, unchanged, changedPrevious, deleted}
+
+	ops := Diff(zoneApex, desired, existing)
+
+	want := map[string]OpType{
+		recordSetKey(created.Name, created.Type): OpCreate,
+		recordSetKey(changed.Name, changed.Type): OpUpdate,
+		recordSetKey(deleted.Name, deleted.Type): OpDelete,
+	}
+
+	if len(ops) != len(want) {
+		t.Fatalf("expected %d ops, got %d: %+v", len(want), len(ops), ops)
+	}
+
+	for _, op := range ops {
+		key := recordSetKey(op.Set.Name, op.Set.Type)
+		wantType, ok := want[key]
+		if !ok {
+			t.Errorf("unexpected op for %q: %s (SOA/apex-NS must never be touched, and unchanged sets must be skipped)", key, op.Type)
+			continue
+		}
+		if op.Type != wantType {
+			t.Errorf("op for %q: got %s, want %s", key, op.Type, wantType)
+		}
+	}
+}
+
+func TestFromAzureRecordSetReconstructsFqdn(t *testing.T) {
+	const zoneApex = "example.com"
+
+	cases := []struct {
+		name     string
+		wantFqdn string
+	}{
+		{name: "@", wantFqdn: "example.com."},
+		{name: "www", wantFqdn: "www.example.com."},
+	}
+
+	for _, tc := range cases {
+		ip := "203.0.113.1"
+		props := azuredns.RecordSetProperties{
+			TTL:      int64Ptr(300),
+			ARecords: &[]azuredns.ARecord{{Ipv4Address: &ip}},
+		}
+
+		set, err := FromAzureRecordSet(tc.name, zoneApex, azuredns.A, props)
+		if err != nil {
+			t.Fatalf("FromAzureRecordSet(%q): %s", tc.name, err)
+		}
+
+		if set.Name != tc.wantFqdn {
+			t.Errorf("FromAzureRecordSet(%q): got name %q, want %q", tc.name, set.Name, tc.wantFqdn)
+		}
+	}
+}
+
+func TestToRelativeNameRoundTrips(t *testing.T) {
+	const zoneApex = "example.com"
+
+	cases := []struct {
+		fqdn string
+		want string
+	}{
+		{fqdn: "example.com.", want: "@"},
+		{fqdn: "www.example.com.", want: "www"},
+	}
+
+	for _, tc := range cases {
+		got := ToRelativeName(tc.fqdn, zoneApex)
+		if got != tc.want {
+			t.Errorf("ToRelativeName(%q): got %q, want %q", tc.fqdn, got, tc.want)
+		}
+		if back := FqdnFromRelativeName(got, zoneApex); back != tc.fqdn {
+			t.Errorf("FqdnFromRelativeName(%q): got %q, want %q", got, back, tc.fqdn)
+		}
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestDiffMatchesRecordSetsRegardlessOfNameCase(t *testing.T) {
+	const zoneApex = "example.com."
+
+	// Azure DNS always returns lowercase names; a zone file is free to use
+	// any case for the same owner name. They must still be recognized as
+	// the same record set instead of producing a spurious update/create.
+	desired := []RecordSet{aRecordSet("WWW.example.com.", 300, "203.0.113.1")}
+	existing := []RecordSet{aRecordSet("www.example.com.", 300, "203.0.113.1")}
+
+	ops := Diff(zoneApex, desired, existing)
+	if len(ops) != 0 {
+		t.Fatalf("expected no ops for a record set that only differs by name case, got %+v", ops)
+	}
+}
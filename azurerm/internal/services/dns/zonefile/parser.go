@@ -0,0 +1,122 @@
+// Package zonefile parses RFC 1035 zone files and AXFR transfers into the
+// record-set shape that Azure DNS's RecordSetsClient expects, so that a
+// bulk-import resource can replay an existing zone into Azure without the
+// caller hand-authoring one `azurerm_dns_*_record` block per RR.
+package zonefile
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// RecordSet groups the resource records that share an owner name and type,
+// mirroring how Azure DNS materializes a "record set" from potentially many
+// individual RRs in a zone file.
+type RecordSet struct {
+	Name string
+	Type uint16
+	TTL  uint32
+	RRs  []dns.RR
+}
+
+// Parse reads an RFC 1035 zone file from r and groups the resource records it
+// contains into RecordSets keyed by owner name + type. $ORIGIN, $TTL and
+// $INCLUDE directives are handled by the underlying dns.ZoneParser.
+func Parse(r io.Reader, origin string) ([]RecordSet, error) {
+	zp := dns.NewZoneParser(r, origin, "")
+	zp.SetIncludeAllowed(true)
+
+	sets := map[string]*RecordSet{}
+	var order []string
+
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		addRR(sets, &order, rr)
+	}
+
+	if err := zp.Err(); err != nil {
+		return nil, fmt.Errorf("parsing zone file: %w", err)
+	}
+
+	return orderedRecordSets(sets, order), nil
+}
+
+// addRR files rr into sets under its owner name + type key, keeping the
+// lowest TTL seen for that set, as Azure DNS record sets carry a single TTL
+// shared by every record in the set.
+func addRR(sets map[string]*RecordSet, order *[]string, rr dns.RR) {
+	hdr := rr.Header()
+	key := recordSetKey(hdr.Name, hdr.Rrtype)
+
+	set, found := sets[key]
+	if !found {
+		set = &RecordSet{Name: hdr.Name, Type: hdr.Rrtype, TTL: hdr.Ttl}
+		sets[key] = set
+		*order = append(*order, key)
+	} else if hdr.Ttl < set.TTL {
+		set.TTL = hdr.Ttl
+	}
+
+	set.RRs = append(set.RRs, rr)
+}
+
+func orderedRecordSets(sets map[string]*RecordSet, order []string) []RecordSet {
+	results := make([]RecordSet, 0, len(order))
+	for _, key := range order {
+		results = append(results, *sets[key])
+	}
+	return results
+}
+
+// recordSetKey identifies a record set by owner name + type. DNS names are
+// case-insensitive and Azure DNS normalizes record set names to lowercase,
+// so the name is lowercased here to keep desired (zone-file/AXFR-sourced,
+// whatever case the source used) and existing (Azure-sourced, always
+// lowercase) keys comparable.
+func recordSetKey(name string, rrtype uint16) string {
+	return fmt.Sprintf("%s|%d", strings.ToLower(name), rrtype)
+}
+
+// IsAzureManaged reports whether a record set is one Azure DNS maintains
+// automatically and therefore must never be created, updated or deleted by
+// an import: the zone's SOA, and the apex NS record set.
+func IsAzureManaged(zoneApex string, set RecordSet) bool {
+	if set.Type == dns.TypeSOA {
+		return true
+	}
+	return set.Type == dns.TypeNS && strings.EqualFold(dns.Fqdn(set.Name), dns.Fqdn(zoneApex))
+}
+
+// FqdnFromRelativeName reconstructs the fully-qualified owner name for a
+// record set returned by RecordSetsClient.ListByDNSZone, whose Name is
+// always relative to the zone ("@" for the zone apex itself, e.g. "www" for
+// "www.example.com" in zone "example.com").
+func FqdnFromRelativeName(relativeName, zoneApex string) string {
+	apex := dns.Fqdn(zoneApex)
+
+	if relativeName == "@" || relativeName == "" {
+		return apex
+	}
+
+	return dns.Fqdn(relativeName + "." + apex)
+}
+
+// ToRelativeName is the inverse of FqdnFromRelativeName: it converts a fully
+// qualified owner name back into the relative name
+// RecordSetsClient.CreateOrUpdate/Delete expect ("@" for the zone apex).
+func ToRelativeName(fqdn, zoneApex string) string {
+	fqdn = dns.Fqdn(fqdn)
+	apex := dns.Fqdn(zoneApex)
+
+	if strings.EqualFold(fqdn, apex) {
+		return "@"
+	}
+
+	if idx := len(fqdn) - len(apex); idx > 0 && strings.EqualFold(fqdn[idx:], apex) {
+		return fqdn[:idx-1]
+	}
+
+	return strings.TrimSuffix(fqdn, "."+apex)
+}
@@ -0,0 +1,83 @@
+package zonefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestParseGroupsMultipleRRsIntoOneRecordSet(t *testing.T) {
+	zone := `$ORIGIN example.com.
+$TTL 300
+www		A	203.0.113.1
+www		A	203.0.113.2
+mail	300	MX	10 mx1.example.com.
+`
+
+	sets, err := Parse(strings.NewReader(zone), "example.com.")
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+
+	if len(sets) != 2 {
+		t.Fatalf("expected 2 record sets, got %d: %+v", len(sets), sets)
+	}
+
+	www := sets[0]
+	if www.Name != "www.example.com." || www.Type != dns.TypeA {
+		t.Fatalf("unexpected first record set: %+v", www)
+	}
+	if len(www.RRs) != 2 {
+		t.Fatalf("expected the two www A records to be grouped into one set, got %d RRs", len(www.RRs))
+	}
+
+	mail := sets[1]
+	if mail.Name != "mail.example.com." || mail.Type != dns.TypeMX {
+		t.Fatalf("unexpected second record set: %+v", mail)
+	}
+}
+
+func TestParseUsesLowestTTLInGroup(t *testing.T) {
+	zone := `$ORIGIN example.com.
+www	300	A	203.0.113.1
+www	60	A	203.0.113.2
+`
+
+	sets, err := Parse(strings.NewReader(zone), "example.com.")
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+
+	if len(sets) != 1 {
+		t.Fatalf("expected 1 record set, got %d", len(sets))
+	}
+	if sets[0].TTL != 60 {
+		t.Errorf("expected the set TTL to be the lowest of the group (60), got %d", sets[0].TTL)
+	}
+}
+
+func TestParseRejectsMalformedZoneFile(t *testing.T) {
+	zone := `$ORIGIN example.com.
+www IN A this-is-not-an-ip
+`
+
+	if _, err := Parse(strings.NewReader(zone), "example.com."); err == nil {
+		t.Fatal("expected an error parsing a malformed zone file, got nil")
+	}
+}
+
+func TestRecordSetKeyIsCaseInsensitive(t *testing.T) {
+	if recordSetKey("WWW.example.com.", dns.TypeA) != recordSetKey("www.example.com.", dns.TypeA) {
+		t.Error("recordSetKey should treat owner names as case-insensitive")
+	}
+}
+
+func TestIsAzureManagedIsCaseInsensitive(t *testing.T) {
+	const zoneApex = "Example.com."
+
+	apexNS := RecordSet{Name: "example.COM.", Type: dns.TypeNS}
+	if !IsAzureManaged(zoneApex, apexNS) {
+		t.Error("expected the apex NS record set to be recognized as Azure-managed regardless of name case")
+	}
+}
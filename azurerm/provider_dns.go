@@ -0,0 +1,26 @@
+package azurerm
+
+import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+// dnsResources returns the `azurerm_dns_*` resources added in this series.
+// In the full provider these entries live in provider.go's ResourcesMap
+// alongside every other service's resources; that file isn't part of this
+// reduced tree, so they're grouped here instead and need folding into the
+// real ResourcesMap (keyed the same way) when this tree is merged back in.
+func dnsResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_dns_zone_import": resourceArmDnsZoneImport(),
+		"azurerm_dns_caa_record":  resourceArmDnsCaaRecord(),
+		"azurerm_dns_ptr_record":  resourceArmDnsPtrRecord(),
+	}
+}
+
+// dnsDataSources is the data-source equivalent of dnsResources, destined for
+// provider.go's DataSourcesMap.
+func dnsDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_dns_aaaa_record": dataSourceArmDnsAaaaRecord(),
+		"azurerm_dns_caa_record":  dataSourceArmDnsCaaRecord(),
+		"azurerm_dns_ptr_record":  dataSourceArmDnsPtrRecord(),
+	}
+}
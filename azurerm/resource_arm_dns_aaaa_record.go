@@ -2,7 +2,9 @@ package azurerm
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
@@ -47,9 +49,13 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 			},
 
 			"records": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPv6Address,
+					StateFunc:    stateFuncIPv6Address,
+				},
 				Set:           schema.HashString,
 				ConflictsWith: []string{"target_resource_id"},
 			},
@@ -64,6 +70,10 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 				Computed: true,
 			},
 
+			"etag": schemaDnsEtag(),
+
+			"concurrency_mode": schemaDnsConcurrencyMode(),
+
 			"tags": tags.Schema(),
 
 			"target_resource_id": {
@@ -121,10 +131,9 @@ func resourceArmDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta interface
 		},
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.AAAA, parameters, eTag, ifNoneMatch); err != nil {
-		return fmt.Errorf("Error creating/updating DNS AAAA Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+	ifMatch, ifNoneMatch := dnsRecordSetPreconditions(d)
+	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.AAAA, parameters, ifMatch, ifNoneMatch); err != nil {
+		return fmt.Errorf("Error creating/updating DNS AAAA Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, dnsRecordSetConcurrencyError("DNS AAAA Record", name, err))
 	}
 
 	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.AAAA)
@@ -171,6 +180,7 @@ func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("zone_name", zoneName)
 	d.Set("ttl", resp.TTL)
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 	d.Set("target_resource_id", targetResource.ID)
 
 	// Only flatten DNS records if they are present in the resource, e.g. not for alias records
@@ -209,7 +219,7 @@ func flattenAzureRmDnsAaaaRecords(records *[]dns.AaaaRecord) []string {
 
 	if records != nil {
 		for _, record := range *records {
-			results = append(results, *record.Ipv6Address)
+			results = append(results, canonicalIPv6Address(*record.Ipv6Address))
 		}
 	}
 
@@ -221,7 +231,7 @@ func expandAzureRmDnsAaaaRecords(d *schema.ResourceData) *[]dns.AaaaRecord {
 	records := make([]dns.AaaaRecord, len(recordStrings))
 
 	for i, v := range recordStrings {
-		ipv6 := v.(string)
+		ipv6 := canonicalIPv6Address(v.(string))
 		records[i] = dns.AaaaRecord{
 			Ipv6Address: &ipv6,
 		}
@@ -229,3 +239,44 @@ func expandAzureRmDnsAaaaRecords(d *schema.ResourceData) *[]dns.AaaaRecord {
 
 	return &records
 }
+
+// validateIPv6Address ensures a `records` entry is a syntactically valid,
+// non-IPv4-mapped IPv6 literal, rejecting malformed input before it ever
+// reaches Azure.
+func validateIPv6Address(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	ip := net.ParseIP(v)
+	if ip == nil || ip.To16() == nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid IPv6 address: %q", k, v))
+		return warnings, errors
+	}
+
+	if ip.To4() != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IPv6 address, got an IPv4-mapped address: %q", k, v))
+		return warnings, errors
+	}
+
+	return warnings, errors
+}
+
+// stateFuncIPv6Address canonicalizes an IPv6 literal into its RFC 5952 form
+// before it's hashed into the `records` set, so that config and the address
+// Azure returns on Read compare equal regardless of the textual form (zero
+// compression, leading zeros, case) the user wrote.
+func stateFuncIPv6Address(i interface{}) string {
+	return canonicalIPv6Address(i.(string))
+}
+
+func canonicalIPv6Address(address string) string {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return address
+	}
+
+	return addr.String()
+}
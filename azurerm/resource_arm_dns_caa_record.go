@@ -0,0 +1,243 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDnsCaaRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsCaaRecordCreateUpdate,
+		Read:   resourceArmDnsCaaRecordRead,
+		Update: resourceArmDnsCaaRecordCreateUpdate,
+		Delete: resourceArmDnsCaaRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flags": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+
+						"tag": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"issue", "issuewild", "iodef"}, false),
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": schemaDnsEtag(),
+
+			"concurrency_mode": schemaDnsConcurrencyMode(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmDnsCaaRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.CAA)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing DNS CAA Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_caa_record", *existing.ID)
+		}
+	}
+
+	ttl := int64(d.Get("ttl").(int))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := dns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &dns.RecordSetProperties{
+			Metadata:   tags.Expand(t),
+			TTL:        &ttl,
+			CaaRecords: expandAzureRmDnsCaaRecords(d),
+		},
+	}
+
+	ifMatch, ifNoneMatch := dnsRecordSetPreconditions(d)
+	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.CAA, parameters, ifMatch, ifNoneMatch); err != nil {
+		return fmt.Errorf("Error creating/updating DNS CAA Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, dnsRecordSetConcurrencyError("DNS CAA Record", name, err))
+	}
+
+	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.CAA)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DNS CAA Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read DNS CAA Record %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsCaaRecordRead(d, meta)
+}
+
+func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["CAA"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.CAA)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DNS CAA record %s: %v", name, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+	d.Set("ttl", resp.TTL)
+	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
+
+	if err := d.Set("record", flattenAzureRmDnsCaaRecords(resp.CaaRecords)); err != nil {
+		return err
+	}
+	return tags.FlattenAndSet(d, resp.Metadata)
+}
+
+func resourceArmDnsCaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	dnsClient := meta.(*ArmClient).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["CAA"]
+	zoneName := id.Path["dnszones"]
+
+	resp, err := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.CAA, "")
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Error deleting DNS CAA Record %s: %+v", name, err)
+	}
+
+	return nil
+}
+
+func flattenAzureRmDnsCaaRecords(records *[]dns.CaaRecord) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+
+	if records != nil {
+		for _, record := range *records {
+			caaRecord := make(map[string]interface{})
+
+			if record.Flags != nil {
+				caaRecord["flags"] = int(*record.Flags)
+			}
+			caaRecord["tag"] = *record.Tag
+			caaRecord["value"] = *record.Value
+
+			results = append(results, caaRecord)
+		}
+	}
+
+	return results
+}
+
+func expandAzureRmDnsCaaRecords(d *schema.ResourceData) *[]dns.CaaRecord {
+	recordStrings := d.Get("record").(*schema.Set).List()
+	records := make([]dns.CaaRecord, len(recordStrings))
+
+	for i, v := range recordStrings {
+		caaRecord := v.(map[string]interface{})
+		flags := int32(caaRecord["flags"].(int))
+		tag := caaRecord["tag"].(string)
+		value := caaRecord["value"].(string)
+
+		records[i] = dns.CaaRecord{
+			Flags: &flags,
+			Tag:   &tag,
+			Value: &value,
+		}
+	}
+
+	return &records
+}
@@ -0,0 +1,344 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	azuredns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/miekg/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/zonefile"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// resourceArmDnsZoneImport bulk-materializes the record sets described by a
+// zone file (or fetched live via AXFR) into an existing Azure DNS zone,
+// using the same RecordSetsClient that the individual `azurerm_dns_*_record`
+// resources use. It's a reconciling, not a one-shot, resource: every apply
+// re-parses/re-transfers the source and re-diffs it against whatever is
+// currently in the zone, so out-of-band changes are picked up on the next
+// run just like any other Terraform resource.
+func resourceArmDnsZoneImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsZoneImportCreateUpdate,
+		Read:   resourceArmDnsZoneImportRead,
+		Update: resourceArmDnsZoneImportCreateUpdate,
+		Delete: resourceArmDnsZoneImportDelete,
+
+		// This resource reconciles against whatever is currently in the zone,
+		// not against a value Terraform core can diff the tracked attributes
+		// against - out-of-band drift (a record edited in the Portal, or the
+		// source zone file/AXFR content changing between applies) never
+		// touches zone_file/source_server/etc. Force a diff on every plan, the
+		// same way null_resource's triggers-by-timestamp pattern does, so
+		// CreateUpdate actually re-diffs and re-applies every run instead of
+		// Terraform reporting "No changes" forever after the first apply.
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			return d.SetNewComputed("plan")
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_server"},
+			},
+
+			"source_server": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Address (host:port) of a name server to AXFR the zone from, e.g. the BIND/PowerDNS server being migrated away from.",
+				ConflictsWith: []string{"zone_file"},
+			},
+
+			"tsig_key_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_server", "tsig_secret"},
+			},
+
+			"tsig_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hmac-sha256",
+				ValidateFunc: validation.StringInSlice([]string{"hmac-sha256", "hmac-sha512", "hmac-sha1"}, false),
+			},
+
+			"tsig_secret": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"source_server", "tsig_key_name"},
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"rate_limit_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				Description:  "Maximum record set operations per second; 0 (the default) means unlimited.",
+				ValidateFunc: validation.FloatBetween(0, 1000),
+			},
+
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"plan": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Human-readable summary of the create/update/delete operations computed on the last apply. Always populated, even when dry_run is false.",
+			},
+		},
+	}
+}
+
+func resourceArmDnsZoneImportCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	dryRun := d.Get("dry_run").(bool)
+
+	desired, err := loadDesiredRecordSets(d, zoneName)
+	if err != nil {
+		return fmt.Errorf("loading source records for DNS zone %q (Resource Group %q): %s", zoneName, resGroup, err)
+	}
+
+	existing, err := listAzureDnsRecordSets(ctx, client, resGroup, zoneName)
+	if err != nil {
+		return fmt.Errorf("listing existing record sets in DNS zone %q (Resource Group %q): %s", zoneName, resGroup, err)
+	}
+
+	ops := zonefile.Diff(zoneName, desired, existing)
+	plan := formatZoneImportPlan(ops)
+
+	if !dryRun {
+		concurrency := d.Get("concurrency").(int)
+		ratePerSecond := d.Get("rate_limit_per_second").(float64)
+
+		if err := applyZoneImportOps(ctx, client, resGroup, zoneName, ops, concurrency, ratePerSecond); err != nil {
+			return fmt.Errorf("applying zone import for DNS zone %q (Resource Group %q): %s", zoneName, resGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/dnsZoneImport", resGroup, zoneName))
+	d.Set("plan", plan)
+
+	return resourceArmDnsZoneImportRead(d, meta)
+}
+
+func resourceArmDnsZoneImportRead(d *schema.ResourceData, meta interface{}) error {
+	// There's no single Azure object backing this resource - it's a
+	// reconciling action replayed against the record sets in the zone on
+	// every apply - so Read only has the state already populated by
+	// resourceArmDnsZoneImportCreateUpdate to work with.
+	return nil
+}
+
+func resourceArmDnsZoneImportDelete(d *schema.ResourceData, meta interface{}) error {
+	// Deleting this resource only stops Terraform from reconciling the zone
+	// against the source going forward; it deliberately does not remove the
+	// record sets that were imported, since those are now just ordinary
+	// records in the zone that may be relied upon independently of this
+	// resource.
+	return nil
+}
+
+func loadDesiredRecordSets(d *schema.ResourceData, zoneName string) ([]zonefile.RecordSet, error) {
+	if zoneFile, ok := d.GetOk("zone_file"); ok {
+		return zonefile.Parse(strings.NewReader(zoneFile.(string)), zoneName)
+	}
+
+	sourceServer, ok := d.GetOk("source_server")
+	if !ok {
+		return nil, fmt.Errorf("one of 'zone_file' or 'source_server' must be set")
+	}
+
+	var tsigConf *zonefile.TSIGConfig
+	if keyName, ok := d.GetOk("tsig_key_name"); ok {
+		tsigConf = &zonefile.TSIGConfig{
+			KeyName:   keyName.(string),
+			Algorithm: tsigAlgorithmToMiekg(d.Get("tsig_algorithm").(string)),
+			Secret:    d.Get("tsig_secret").(string),
+		}
+	}
+
+	return zonefile.Transfer(sourceServer.(string), zoneName, tsigConf)
+}
+
+func tsigAlgorithmToMiekg(algorithm string) string {
+	switch algorithm {
+	case "hmac-sha512":
+		return "hmac-sha512."
+	case "hmac-sha1":
+		return "hmac-sha1."
+	default:
+		return "hmac-sha256."
+	}
+}
+
+func listAzureDnsRecordSets(ctx context.Context, client azuredns.RecordSetsClient, resGroup, zoneName string) ([]zonefile.RecordSet, error) {
+	var results []zonefile.RecordSet
+
+	page, err := client.ListByDNSZone(ctx, resGroup, zoneName, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for page.NotDone() {
+		for _, rs := range page.Values() {
+			if rs.Name == nil || rs.Type == nil || rs.RecordSetProperties == nil {
+				continue
+			}
+
+			recordType := azuredns.RecordType(strings.TrimPrefix(*rs.Type, "Microsoft.Network/dnszones/"))
+			set, err := zonefile.FromAzureRecordSet(*rs.Name, zoneName, recordType, *rs.RecordSetProperties)
+			if err != nil {
+				// Record types this provider doesn't know how to reconcile
+				// (yet) are left untouched rather than failing the import.
+				continue
+			}
+
+			results = append(results, set)
+		}
+
+		if err := page.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func formatZoneImportPlan(ops []zonefile.Op) string {
+	if len(ops) == 0 {
+		return "no changes: the zone already matches the source"
+	}
+
+	var b strings.Builder
+	for _, op := range ops {
+		fmt.Fprintf(&b, "%s %s %s\n", strings.ToUpper(string(op.Type)), op.Set.Name, azureTypeName(op.Set.Type))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func applyZoneImportOps(ctx context.Context, client azuredns.RecordSetsClient, resGroup, zoneName string, ops []zonefile.Op, concurrency int, ratePerSecond float64) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var ticker *time.Ticker
+	if ratePerSecond > 0 {
+		interval := time.Duration(float64(time.Second) / ratePerSecond)
+		if interval < time.Nanosecond {
+			interval = time.Nanosecond
+		}
+		ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+	}
+
+	sem := make(chan struct{}, concurrency)
+	errs := make(chan error, len(ops))
+	var wg sync.WaitGroup
+
+	for _, op := range ops {
+		op := op
+
+		if ticker != nil {
+			<-ticker.C
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := applyZoneImportOp(ctx, client, resGroup, zoneName, op); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	var result *multierror.Error
+	for err := range errs {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func applyZoneImportOp(ctx context.Context, client azuredns.RecordSetsClient, resGroup, zoneName string, op zonefile.Op) error {
+	// op.Set.Name is always the fully-qualified owner name (Diff compares
+	// both sides in that form); RecordSetsClient wants it relative to the
+	// zone, with "@" for the apex.
+	name := zonefile.ToRelativeName(op.Set.Name, zoneName)
+
+	switch op.Type {
+	case zonefile.OpDelete:
+		recordType, _, err := zonefile.ToAzureRecordSet(op.Set)
+		if err != nil {
+			return err
+		}
+		if _, err := client.Delete(ctx, resGroup, zoneName, name, recordType, ""); err != nil {
+			return fmt.Errorf("deleting record set %q (%s): %s", name, recordType, err)
+		}
+
+	case zonefile.OpCreate, zonefile.OpUpdate:
+		recordType, props, err := zonefile.ToAzureRecordSet(op.Set)
+		if err != nil {
+			return err
+		}
+
+		parameters := azuredns.RecordSet{Name: &name, RecordSetProperties: &props}
+		if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, recordType, parameters, "", ""); err != nil {
+			return fmt.Errorf("%s record set %q (%s): %s", op.Type, name, recordType, err)
+		}
+	}
+
+	return nil
+}
+
+func azureTypeName(rrtype uint16) string {
+	if name, ok := dns.TypeToString[rrtype]; ok {
+		return name
+	}
+	return fmt.Sprintf("type %d", rrtype)
+}
@@ -0,0 +1,49 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/zonefile"
+)
+
+func TestFormatZoneImportPlan(t *testing.T) {
+	if got := formatZoneImportPlan(nil); got != "no changes: the zone already matches the source" {
+		t.Errorf("formatZoneImportPlan(nil) = %q", got)
+	}
+
+	ops := []zonefile.Op{
+		{Type: zonefile.OpCreate, Set: zonefile.RecordSet{Name: "new.example.com.", Type: dns.TypeA}},
+		{Type: zonefile.OpDelete, Set: zonefile.RecordSet{Name: "old.example.com.", Type: dns.TypeA}},
+	}
+
+	want := "CREATE new.example.com. A\nDELETE old.example.com. A"
+	if got := formatZoneImportPlan(ops); got != want {
+		t.Errorf("formatZoneImportPlan(ops) = %q, want %q", got, want)
+	}
+}
+
+func TestTsigAlgorithmToMiekg(t *testing.T) {
+	cases := map[string]string{
+		"hmac-sha256": "hmac-sha256.",
+		"hmac-sha512": "hmac-sha512.",
+		"hmac-sha1":   "hmac-sha1.",
+		"":            "hmac-sha256.",
+		"unknown":     "hmac-sha256.",
+	}
+
+	for in, want := range cases {
+		if got := tsigAlgorithmToMiekg(in); got != want {
+			t.Errorf("tsigAlgorithmToMiekg(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestAzureTypeName(t *testing.T) {
+	if got := azureTypeName(dns.TypeA); got != "A" {
+		t.Errorf("azureTypeName(TypeA) = %q, want %q", got, "A")
+	}
+	if got := azureTypeName(65280); got != "type 65280" {
+		t.Errorf("azureTypeName(65280) = %q, want %q", got, "type 65280")
+	}
+}